@@ -0,0 +1,377 @@
+package main
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+)
+
+// Cache is a generic, thread-safe, in-memory cache keyed on any comparable
+// type and holding values of any type. Implementations evict entries once
+// TTL expires or the configured capacity is exceeded.
+type Cache[K comparable, V any] interface {
+	// Set inserts or updates the value for key and refreshes its TTL.
+	Set(key K, value V, ttl time.Duration)
+	// Get returns the value for key and whether it was found and not expired.
+	Get(key K) (V, bool)
+	// Peek returns the value for key without marking it as recently used.
+	Peek(key K) (V, bool)
+	// Contains reports whether key is present without affecting recency.
+	Contains(key K) bool
+	// Remove deletes key from the cache, reporting whether it was present.
+	Remove(key K) bool
+	// Purge removes all entries from the cache.
+	Purge()
+	// Keys returns the keys currently in the cache. Order is unspecified: it
+	// depends on the configured EvictionPolicy's internal bookkeeping, which
+	// isn't guaranteed to expose a single meaningful ordering across policies.
+	Keys() []K
+	// Values returns the values currently in the cache. Order is unspecified;
+	// see Keys.
+	Values() []V
+	// Len returns the number of entries currently in the cache.
+	Len() int
+	// Stats returns a snapshot of the cache's hit/miss/eviction counters.
+	Stats() Stats
+	// Close stops the cache's background sweeper. A Cache that's simply
+	// dropped without calling Close is still cleaned up via finalizer, but
+	// Close gives deterministic, immediate shutdown.
+	Close() error
+}
+
+// Stats is a point-in-time snapshot of cache activity.
+type Stats struct {
+	Hits       int64
+	Misses     int64
+	Evictions  int64
+	Entries    int
+	BytesInUse int64
+}
+
+// entryOverhead is a fixed per-entry cost added to every entry's measured
+// value size when the cache is capacity-bounded by WithMaxBytes, to account
+// for map/heap/policy bookkeeping rather than pretending an entry costs
+// exactly len(value) bytes.
+const entryOverhead = 64
+
+// entry is the unit of data tracked by cache.items and, depending on the
+// configured EvictionPolicy, by that policy's own bookkeeping too (an LRU
+// list, LFU frequency buckets, or 2Q's A1in/A1out/Am queues).
+//
+// Every live entry has exactly one entry in the items map, exactly one slot
+// in whatever structure the EvictionPolicy uses to track it, and, if it has
+// an expiry, exactly one slot in the expiry heap tracked by heapIndex.
+// Set-on-existing-key must update all of these in place, not just swap the
+// value.
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	time      time.Time
+	expireAt  time.Time // zero means "never expires"
+	heapIndex int       // index in cache.expiry, or -1 if not in the heap
+	size      int64     // cost charged against Options.MaxBytes, 0 if unset
+}
+
+// cache is the default Cache implementation: a lookup map, a pluggable
+// EvictionPolicy deciding what to drop when the cache is full, and a
+// min-heap of entries ordered by expireAt so the sweeper can find what's
+// expired without scanning every entry.
+type cache[K comparable, V any] struct {
+	lock        sync.Mutex
+	items       map[K]*entry[K, V]
+	policy      EvictionPolicy[K, V]
+	expiry      expiryHeap[K, V]
+	options     Options[K, V]
+	stats       Stats
+	currentSize int64
+}
+
+// NewCache creates a new generic Cache with the given options applied. The
+// returned Cache owns a background sweeper goroutine; call Close when done
+// with it, or simply drop it and let the finalizer stop the sweeper.
+func NewCache[K comparable, V any](opts ...Option[K, V]) Cache[K, V] {
+	cacheOptions := resolveOptions(opts...)
+
+	policy := cacheOptions.Policy
+	if policy == nil {
+		policy = newPolicyByName[K, V](cacheOptions.EvictionPolicyName)
+	}
+
+	inner := &cache[K, V]{
+		items:   make(map[K]*entry[K, V]),
+		policy:  policy,
+		options: cacheOptions,
+	}
+
+	return newCacheHandle(inner, cacheOptions.SweepInterval)
+}
+
+// Set inserts or updates the value for key. ttl == 0 uses the cache's
+// configured default TTL (see WithTTL); ttl < 0 means the entry never
+// expires, regardless of the cache default.
+func (c *cache[K, V]) Set(key K, value V, ttl time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	expireAt := c.expiryFor(ttl)
+	size := c.sizeOf(value)
+
+	if ent, ok := c.items[key]; ok {
+		old := ent.value
+		c.currentSize += size - ent.size
+		ent.value = value
+		ent.size = size
+		ent.time = time.Now()
+		c.setExpiry(ent, expireAt)
+		c.policy.OnAccess(ent)
+		if c.options.OnEvicted != nil {
+			c.options.OnEvicted(key, old, ReasonReplaced)
+		}
+		c.evictUntilWithinMaxBytes(ent)
+		return
+	}
+
+	if c.options.MaxEntries > 0 && len(c.items) >= c.options.MaxEntries {
+		c.evict()
+	}
+
+	ent := &entry[K, V]{key: key, value: value, time: time.Now(), heapIndex: -1, size: size}
+	c.items[key] = ent
+	c.currentSize += size
+	c.setExpiry(ent, expireAt)
+	c.policy.OnInsert(ent)
+	c.evictUntilWithinMaxBytes(ent)
+}
+
+// Get retrieves the value for key, marking it as recently used.
+func (c *cache[K, V]) Get(key K) (V, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	ent, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	if c.expired(ent) {
+		c.removeEntry(ent, ReasonExpired)
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	c.policy.OnAccess(ent)
+	c.stats.Hits++
+	return ent.value, true
+}
+
+// Peek returns the value for key without affecting its recency.
+func (c *cache[K, V]) Peek(key K) (V, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	ent, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	if c.expired(ent) {
+		var zero V
+		return zero, false
+	}
+	return ent.value, true
+}
+
+// Contains reports whether key is present without affecting recency.
+func (c *cache[K, V]) Contains(key K) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	ent, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	return !c.expired(ent)
+}
+
+// Remove deletes key from the cache, reporting whether it was present.
+func (c *cache[K, V]) Remove(key K) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	ent, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.removeEntry(ent, ReasonManual)
+	return true
+}
+
+// Purge removes all entries from the cache.
+func (c *cache[K, V]) Purge() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, ent := range c.items {
+		c.policy.OnRemove(ent)
+	}
+	c.items = make(map[K]*entry[K, V])
+	c.expiry = nil
+	c.currentSize = 0
+}
+
+// Keys returns the keys currently in the cache. Order is unspecified: it
+// depends on the configured EvictionPolicy's internal bookkeeping, which
+// isn't guaranteed to expose a single meaningful ordering across policies.
+func (c *cache[K, V]) Keys() []K {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	keys := make([]K, 0, len(c.items))
+	for k := range c.items {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values returns the values currently in the cache. Order is unspecified;
+// see Keys.
+func (c *cache[K, V]) Values() []V {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	values := make([]V, 0, len(c.items))
+	for _, ent := range c.items {
+		values = append(values, ent.value)
+	}
+	return values
+}
+
+// Len returns the number of entries currently in the cache.
+func (c *cache[K, V]) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return len(c.items)
+}
+
+// Stats returns a snapshot of the cache's hit/miss/eviction counters along
+// with its current entry count and byte usage.
+func (c *cache[K, V]) Stats() Stats {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	stats := c.stats
+	stats.Entries = len(c.items)
+	stats.BytesInUse = c.currentSize
+	return stats
+}
+
+// sweep evicts every entry whose expiry has passed, in expireAt order. The
+// expiry heap lets it stop as soon as it hits an entry that hasn't expired
+// yet, rather than scanning the whole cache. It's the one thing the
+// background sweeper goroutine calls.
+func (c *cache[K, V]) sweep() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := time.Now()
+	for len(c.expiry) > 0 && !c.expiry[0].expireAt.After(now) {
+		// heap.Pop unconditionally shrinks c.expiry, so this loop always
+		// makes progress even if items and expiry were ever to desync.
+		ent := heap.Pop(&c.expiry).(*entry[K, V])
+		if _, ok := c.items[ent.key]; ok {
+			c.removeEntry(ent, ReasonExpired)
+		}
+	}
+}
+
+// expired reports whether ent has outlived its expiry. A zero expireAt
+// means the entry never expires.
+func (c *cache[K, V]) expired(ent *entry[K, V]) bool {
+	return !ent.expireAt.IsZero() && time.Now().After(ent.expireAt)
+}
+
+// expiryFor resolves a Set call's ttl argument against the cache's default
+// TTL into an absolute expiry time: ttl < 0 means no expiry, ttl == 0 means
+// "use the cache default" (itself possibly "no expiry"), and ttl > 0 is a
+// per-entry override.
+func (c *cache[K, V]) expiryFor(ttl time.Duration) time.Time {
+	switch {
+	case ttl < 0:
+		return time.Time{}
+	case ttl == 0:
+		if c.options.TTL <= 0 {
+			return time.Time{}
+		}
+		return time.Now().Add(c.options.TTL)
+	default:
+		return time.Now().Add(ttl)
+	}
+}
+
+// setExpiry updates ent's expiry and keeps the expiry heap consistent with
+// it, pushing, fixing, or removing ent's slot as needed.
+func (c *cache[K, V]) setExpiry(ent *entry[K, V], expireAt time.Time) {
+	ent.expireAt = expireAt
+	switch {
+	case expireAt.IsZero() && ent.heapIndex >= 0:
+		heap.Remove(&c.expiry, ent.heapIndex)
+	case !expireAt.IsZero() && ent.heapIndex < 0:
+		heap.Push(&c.expiry, ent)
+	case !expireAt.IsZero():
+		heap.Fix(&c.expiry, ent.heapIndex)
+	}
+}
+
+// evict asks the configured EvictionPolicy which entry to drop, and removes
+// it to make room for a new one.
+func (c *cache[K, V]) evict() {
+	if ent := c.policy.Evict(); ent != nil {
+		c.removeEntry(ent, ReasonCapacity)
+	}
+}
+
+// removeEntry handles the removal of an entry from the cache, firing
+// OnEvicted with the given reason.
+func (c *cache[K, V]) removeEntry(ent *entry[K, V], reason EvictReason) {
+	delete(c.items, ent.key)
+	c.policy.OnRemove(ent)
+	if ent.heapIndex >= 0 {
+		heap.Remove(&c.expiry, ent.heapIndex)
+	}
+	c.currentSize -= ent.size
+	c.stats.Evictions++
+	if c.options.OnEvicted != nil {
+		c.options.OnEvicted(ent.key, ent.value, reason)
+	}
+}
+
+// sizeOf returns the byte cost to charge against MaxBytes for value. It's 0
+// (i.e. MaxBytes is effectively unenforced) unless a SizeOf func was given.
+func (c *cache[K, V]) sizeOf(value V) int64 {
+	if c.options.SizeOf == nil {
+		return 0
+	}
+	return entryOverhead + c.options.SizeOf(value)
+}
+
+// evictUntilWithinMaxBytes evicts via the configured policy, in a loop,
+// until currentSize is back at or under MaxBytes. It never evicts keep —
+// the entry Set just inserted or updated — so a single oversized entry is
+// allowed to push the cache over its byte budget rather than evict itself.
+func (c *cache[K, V]) evictUntilWithinMaxBytes(keep *entry[K, V]) {
+	if c.options.MaxBytes <= 0 {
+		return
+	}
+	for c.currentSize > c.options.MaxBytes {
+		victim := c.policy.Evict()
+		if victim == nil || victim == keep {
+			return
+		}
+		c.removeEntry(victim, ReasonCapacity)
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// TestCacheSetGet tests the Set and Get methods of the generic Cache
+func TestCacheSetGet(t *testing.T) {
+	cache := NewCache[string, int](WithTTL[string, int](5 * time.Second))
+
+	cache.Set("key", 42, 0)
+
+	val, ok := cache.Get("key")
+	if !ok {
+		t.Fatalf("Get failed: key not found")
+	}
+	if val != 42 {
+		t.Errorf("Get returned incorrect value: got %d, want %d", val, 42)
+	}
+}
+
+// TestCacheExpiration tests that entries are properly expired based on TTL
+func TestCacheExpiration(t *testing.T) {
+	cache := NewCache[string, int](WithTTL[string, int](1 * time.Second))
+
+	cache.Set("key", 42, 0)
+	time.Sleep(2 * time.Second)
+
+	if _, ok := cache.Get("key"); ok {
+		t.Errorf("expected key to have expired")
+	}
+}
+
+// TestCacheEviction tests the eviction policy when the cache reaches its max size
+func TestCacheEviction(t *testing.T) {
+	cache := NewCache[int, int](WithMaxEntries[int, int](4))
+
+	for i := 0; i < 4; i++ {
+		cache.Set(i, i, 0)
+	}
+	cache.Set(4, 4, 0)
+
+	if _, ok := cache.Get(0); ok {
+		t.Errorf("expected oldest entry to have been evicted")
+	}
+	if cache.Len() != 4 {
+		t.Errorf("expected cache length to be 4, got %d", cache.Len())
+	}
+}
+
+// TestCacheRemoveAndPurge tests the Remove and Purge methods
+func TestCacheRemoveAndPurge(t *testing.T) {
+	cache := NewCache[string, string]()
+
+	cache.Set("a", "1", 0)
+	cache.Set("b", "2", 0)
+
+	if !cache.Remove("a") {
+		t.Errorf("expected Remove to report the key was present")
+	}
+	if cache.Remove("a") {
+		t.Errorf("expected Remove to report the key was absent the second time")
+	}
+
+	cache.Purge()
+	if cache.Len() != 0 {
+		t.Errorf("expected cache to be empty after Purge, got %d entries", cache.Len())
+	}
+}
+
+// TestCacheStats tests that hit/miss/eviction counters are tracked
+func TestCacheStats(t *testing.T) {
+	cache := NewCache[string, string]()
+
+	cache.Set("a", "1", 0)
+	cache.Get("a")
+	cache.Get("missing")
+
+	stats := cache.Stats()
+	if stats.Hits != 1 {
+		t.Errorf("expected 1 hit, got %d", stats.Hits)
+	}
+	if stats.Misses != 1 {
+		t.Errorf("expected 1 miss, got %d", stats.Misses)
+	}
+	if stats.Entries != 1 {
+		t.Errorf("expected 1 entry, got %d", stats.Entries)
+	}
+}
+
+// TestCacheMaxBytes tests that Set evicts under a byte budget rather than
+// an entry-count budget, and that Stats reports bytes in use.
+func TestCacheMaxBytes(t *testing.T) {
+	sizeOf := func(v string) int64 { return int64(len(v)) }
+	cache := NewCache[string, string](
+		WithMaxEntries[string, string](0),
+		WithMaxBytes[string, string](entryOverhead*2+10, sizeOf),
+	)
+
+	cache.Set("a", "12345", 0) // 5 bytes + overhead
+	cache.Set("b", "12345", 0) // 5 bytes + overhead, still within budget
+
+	if _, ok := cache.Peek("a"); !ok {
+		t.Fatalf("expected \"a\" to still be present before going over budget")
+	}
+
+	cache.Set("c", "12345", 0) // pushes past budget, should evict the oldest
+
+	if _, ok := cache.Get("a"); ok {
+		t.Errorf("expected \"a\" to have been evicted once over the byte budget")
+	}
+	if cache.Stats().BytesInUse > entryOverhead*2+10 {
+		t.Errorf("expected bytes in use to be back within budget, got %d", cache.Stats().BytesInUse)
+	}
+}
+
+// TestCachePerEntryTTL tests that a per-entry ttl passed to Set overrides
+// the cache's default TTL, and that a negative ttl means "never expires".
+func TestCachePerEntryTTL(t *testing.T) {
+	cache := NewCache[string, int](WithTTL[string, int](time.Hour))
+
+	cache.Set("short", 1, 10*time.Millisecond)
+	cache.Set("forever", 2, -1)
+
+	time.Sleep(30 * time.Millisecond)
+
+	if _, ok := cache.Get("short"); ok {
+		t.Errorf("expected \"short\" to have expired via its own ttl")
+	}
+	if _, ok := cache.Get("forever"); !ok {
+		t.Errorf("expected \"forever\" to still be present despite the cache default TTL")
+	}
+}
+
+// TestCacheOnEvicted tests that OnEvicted fires with the right reason for
+// capacity eviction, TTL expiry, a manual Remove, and a Set that replaces
+// an existing value.
+func TestCacheOnEvicted(t *testing.T) {
+	var reasons []EvictReason
+	cache := NewCache[string, int](
+		WithMaxEntries[string, int](1),
+		WithTTL[string, int](time.Millisecond),
+		WithOnEvicted[string, int](func(key string, value int, reason EvictReason) {
+			reasons = append(reasons, reason)
+		}),
+	)
+
+	cache.Set("a", 1, 0)
+	cache.Set("a", 2, 0) // replaces "a", should fire ReasonReplaced
+	cache.Set("b", 3, 0) // over capacity, should evict "a" with ReasonCapacity
+
+	time.Sleep(2 * time.Millisecond)
+	cache.Get("b") // expired, should fire ReasonExpired
+
+	cache.Set("c", 4, 0)
+	cache.Remove("c") // should fire ReasonManual
+
+	want := []EvictReason{ReasonReplaced, ReasonCapacity, ReasonExpired, ReasonManual}
+	if len(reasons) != len(want) {
+		t.Fatalf("expected %d callbacks, got %d: %v", len(want), len(reasons), reasons)
+	}
+	for i, r := range want {
+		if reasons[i] != r {
+			t.Errorf("callback %d: expected reason %v, got %v", i, r, reasons[i])
+		}
+	}
+}
+
+// TestCacheSweepProgressesOnDesyncedEntry verifies that sweep always makes
+// progress even if an entry is in the expiry heap without a matching items
+// entry — an invariant violation that should never happen in practice, but
+// that sweep must not hang the whole cache under if it ever does.
+func TestCacheSweepProgressesOnDesyncedEntry(t *testing.T) {
+	handle := NewCache[string, int](WithTTL[string, int](time.Millisecond)).(*cacheHandle[string, int])
+
+	handle.Set("a", 1, 0)
+	delete(handle.items, "a") // desync: still in the expiry heap, gone from items
+
+	time.Sleep(2 * time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		handle.sweep()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("sweep did not return; it's spinning on the desynced entry")
+	}
+}
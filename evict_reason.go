@@ -0,0 +1,33 @@
+package main
+
+// EvictReason describes why an entry left the cache, passed to OnEvicted
+// callbacks.
+type EvictReason int
+
+const (
+	// ReasonExpired means the entry's TTL had elapsed when it was accessed
+	// or swept.
+	ReasonExpired EvictReason = iota
+	// ReasonCapacity means the entry was evicted to make room for a new one.
+	ReasonCapacity
+	// ReasonManual means the entry was removed by an explicit Remove/Delete call.
+	ReasonManual
+	// ReasonReplaced means the entry's value was overwritten by a later Set.
+	ReasonReplaced
+)
+
+// String implements fmt.Stringer for EvictReason.
+func (r EvictReason) String() string {
+	switch r {
+	case ReasonExpired:
+		return "expired"
+	case ReasonCapacity:
+		return "capacity"
+	case ReasonManual:
+		return "manual"
+	case ReasonReplaced:
+		return "replaced"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+// expiryHeap is a container/heap min-heap of entries ordered by expireAt.
+// It lets the sweeper pop expired entries in O(log n) instead of scanning
+// every entry in the cache, and lets Set/Delete reposition or remove an
+// entry's slot in O(log n) via its heapIndex back-pointer.
+//
+// Entries with a zero expireAt (no expiry) are never pushed onto the heap.
+type expiryHeap[K comparable, V any] []*entry[K, V]
+
+func (h expiryHeap[K, V]) Len() int { return len(h) }
+
+func (h expiryHeap[K, V]) Less(i, j int) bool {
+	return h[i].expireAt.Before(h[j].expireAt)
+}
+
+func (h expiryHeap[K, V]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].heapIndex = i
+	h[j].heapIndex = j
+}
+
+func (h *expiryHeap[K, V]) Push(x any) {
+	ent := x.(*entry[K, V])
+	ent.heapIndex = len(*h)
+	*h = append(*h, ent)
+}
+
+func (h *expiryHeap[K, V]) Pop() any {
+	old := *h
+	n := len(old)
+	ent := old[n-1]
+	old[n-1] = nil
+	ent.heapIndex = -1
+	*h = old[:n-1]
+	return ent
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"container/heap"
+	"testing"
+	"time"
+)
+
+// TestExpiryHeapOrdersByExpireAt verifies entries pop off in expireAt order
+// and that heapIndex stays accurate as the heap shuffles entries around.
+func TestExpiryHeapOrdersByExpireAt(t *testing.T) {
+	now := time.Now()
+	h := &expiryHeap[string, int]{}
+
+	entries := []*entry[string, int]{
+		{key: "c", expireAt: now.Add(3 * time.Second), heapIndex: -1},
+		{key: "a", expireAt: now.Add(1 * time.Second), heapIndex: -1},
+		{key: "b", expireAt: now.Add(2 * time.Second), heapIndex: -1},
+	}
+	for _, e := range entries {
+		heap.Push(h, e)
+	}
+
+	var order []string
+	for h.Len() > 0 {
+		order = append(order, heap.Pop(h).(*entry[string, int]).key)
+	}
+
+	want := []string{"a", "b", "c"}
+	for i, k := range want {
+		if order[i] != k {
+			t.Errorf("pop order[%d] = %q, want %q (got %v)", i, order[i], k, order)
+		}
+	}
+}
+
+// TestExpiryHeapRemoveByIndex verifies heap.Remove correctly drops an
+// arbitrary element using its tracked heapIndex.
+func TestExpiryHeapRemoveByIndex(t *testing.T) {
+	now := time.Now()
+	h := &expiryHeap[string, int]{}
+
+	a := &entry[string, int]{key: "a", expireAt: now.Add(1 * time.Second), heapIndex: -1}
+	b := &entry[string, int]{key: "b", expireAt: now.Add(2 * time.Second), heapIndex: -1}
+	c := &entry[string, int]{key: "c", expireAt: now.Add(3 * time.Second), heapIndex: -1}
+	for _, e := range []*entry[string, int]{a, b, c} {
+		heap.Push(h, e)
+	}
+
+	heap.Remove(h, b.heapIndex)
+
+	if h.Len() != 2 {
+		t.Fatalf("expected 2 entries remaining, got %d", h.Len())
+	}
+	for _, e := range *h {
+		if e.key == "b" {
+			t.Errorf("expected \"b\" to have been removed")
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// BytesOptions configures a BytesCache.
+type BytesOptions struct {
+	TTL            time.Duration
+	EvictionPolicy string
+	MaxBytes       int64
+	OnEvicted      func(bucket, key string, value []byte, reason EvictReason)
+}
+
+// BytesOption mutates BytesOptions when applied by NewBytesCache.
+type BytesOption func(*BytesOptions)
+
+// WithBytesTTL sets the time-to-live for entries stored in a BytesCache.
+func WithBytesTTL(ttl time.Duration) BytesOption {
+	return func(o *BytesOptions) {
+		o.TTL = ttl
+	}
+}
+
+// WithBytesEvictionPolicy sets the eviction policy used when a BytesCache is full.
+func WithBytesEvictionPolicy(policy string) BytesOption {
+	return func(o *BytesOptions) {
+		o.EvictionPolicy = policy
+	}
+}
+
+// WithBytesMaxBytes caps the cache by total value size in bytes instead of
+// entry count, using len(value) as each entry's cost.
+func WithBytesMaxBytes(n int64) BytesOption {
+	return func(o *BytesOptions) {
+		o.MaxBytes = n
+	}
+}
+
+// WithBytesOnEvicted registers a callback fired whenever an entry leaves the
+// cache, with bucket and key split back out of the façade's composite key.
+func WithBytesOnEvicted(fn func(bucket, key string, value []byte, reason EvictReason)) BytesOption {
+	return func(o *BytesOptions) {
+		o.OnEvicted = fn
+	}
+}
+
+// BytesCache is a bucket/key/[]byte façade over the generic Cache, for
+// callers that don't need typed keys or values and don't want to pay the
+// cost of instantiating Cache[string, []byte] themselves.
+//
+// It is not source-compatible with the pre-generics Cache: Set takes a ttl
+// (time.Duration) in place of the old variadic ...Option, matching Set's
+// per-entry ttl parameter on the generic Cache (see WithTTL vs a per-call
+// override), and configuration is supplied once at construction via
+// BytesOption rather than per call. Callers migrating off the pre-generics
+// API need to update call sites accordingly.
+type BytesCache struct {
+	cache Cache[string, []byte]
+}
+
+// NewBytesCache creates a new BytesCache with the given options applied.
+func NewBytesCache(opts ...BytesOption) *BytesCache {
+	_, genericOpts := resolveBytesOptions(opts)
+	return &BytesCache{
+		cache: NewCache[string, []byte](genericOpts...),
+	}
+}
+
+// resolveBytesOptions applies opts over BytesCache's defaults and translates
+// the result into Option[string, []byte]s, shared by NewBytesCache and
+// NewShardedBytesCache.
+func resolveBytesOptions(opts []BytesOption) (BytesOptions, []Option[string, []byte]) {
+	o := BytesOptions{EvictionPolicy: "Oldest"}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	genericOpts := []Option[string, []byte]{
+		WithTTL[string, []byte](o.TTL),
+		WithEvictionPolicy[string, []byte](o.EvictionPolicy),
+		WithMaxEntries[string, []byte](MaxEntries),
+	}
+	if o.OnEvicted != nil {
+		genericOpts = append(genericOpts, WithOnEvicted[string, []byte](func(composite string, value []byte, reason EvictReason) {
+			bucket, key, _ := strings.Cut(composite, ":")
+			o.OnEvicted(bucket, key, value, reason)
+		}))
+	}
+	if o.MaxBytes > 0 {
+		genericOpts = append(genericOpts, WithMaxBytes[string, []byte](o.MaxBytes, func(value []byte) int64 {
+			return int64(len(value))
+		}))
+	}
+
+	return o, genericOpts
+}
+
+// fullKey combines bucket and key the same way the original Cache did.
+func fullKey(bucket, key string) string {
+	return bucket + ":" + key
+}
+
+// Set inserts a value into the cache. ttl == 0 uses the cache's configured
+// default TTL; ttl < 0 means the entry never expires.
+func (c *BytesCache) Set(bucket, key string, value []byte, ttl time.Duration) error {
+	c.cache.Set(fullKey(bucket, key), value, ttl)
+	return nil
+}
+
+// Get retrieves a value from the cache. It returns an error if the key was
+// never set, has been removed, or has expired; unlike the pre-generics Cache,
+// an expired entry reports the same "not found" error as a missing one
+// rather than a distinct "cache expired" error.
+func (c *BytesCache) Get(bucket, key string) ([]byte, error) {
+	value, ok := c.cache.Get(fullKey(bucket, key))
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return value, nil
+}
+
+// Delete removes an entry from the cache.
+func (c *BytesCache) Delete(bucket, key string) error {
+	if !c.cache.Remove(fullKey(bucket, key)) {
+		return errors.New("not found")
+	}
+	return nil
+}
+
+// Close stops the cache's background sweeper.
+func (c *BytesCache) Close() error {
+	return c.cache.Close()
+}
+
+// Stats returns a snapshot of the cache's hit/miss/eviction counters along
+// with its current entry count and byte usage.
+func (c *BytesCache) Stats() Stats {
+	return c.cache.Stats()
+}
+
+// ShardedBytesCache is a sharded counterpart to BytesCache: the same
+// bucket/key/[]byte API, spread across N independent shards to reduce lock
+// contention under concurrent load.
+type ShardedBytesCache struct {
+	cache *ShardedCache[string, []byte]
+}
+
+// NewShardedBytesCache creates a new ShardedBytesCache with shardCount
+// shards (see NewShardedCache for how shardCount <= 0 is resolved). A
+// WithBytesMaxBytes budget is the cache's total capacity, split across
+// shards, not a per-shard limit (see NewShardedCache).
+func NewShardedBytesCache(shardCount int, opts ...BytesOption) *ShardedBytesCache {
+	_, genericOpts := resolveBytesOptions(opts)
+	return &ShardedBytesCache{
+		cache: NewShardedCache[string, []byte](shardCount, nil, genericOpts...),
+	}
+}
+
+// Set inserts a value into the cache. ttl == 0 uses the cache's configured
+// default TTL; ttl < 0 means the entry never expires.
+func (c *ShardedBytesCache) Set(bucket, key string, value []byte, ttl time.Duration) error {
+	c.cache.Set(fullKey(bucket, key), value, ttl)
+	return nil
+}
+
+// Get retrieves a value from the cache.
+func (c *ShardedBytesCache) Get(bucket, key string) ([]byte, error) {
+	value, ok := c.cache.Get(fullKey(bucket, key))
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return value, nil
+}
+
+// Delete removes an entry from the cache.
+func (c *ShardedBytesCache) Delete(bucket, key string) error {
+	if !c.cache.Remove(fullKey(bucket, key)) {
+		return errors.New("not found")
+	}
+	return nil
+}
+
+// Close stops every shard's background sweeper.
+func (c *ShardedBytesCache) Close() error {
+	return c.cache.Close()
+}
+
+// Stats returns the aggregate hit/miss/eviction counters, entry count, and
+// byte usage across every shard.
+func (c *ShardedBytesCache) Stats() Stats {
+	return c.cache.Stats()
+}
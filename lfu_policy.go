@@ -0,0 +1,93 @@
+package main
+
+import "container/list"
+
+// lfuBucket groups every entry currently at a given access frequency, so
+// incrementing an entry's frequency or finding the least-frequently-used
+// entry is O(1) instead of requiring a sorted structure. This is the
+// bucketed LFU approach described by Shah, Mitra & Matani.
+type lfuBucket[K comparable, V any] struct {
+	freq    int
+	entries map[*entry[K, V]]struct{}
+}
+
+// lfuPolicy evicts the least-frequently-used entry, breaking ties by
+// picking an arbitrary entry from the lowest-frequency bucket. Buckets are
+// kept in a list ordered ascending by freq.
+type lfuPolicy[K comparable, V any] struct {
+	buckets *list.List
+	bucket  map[*entry[K, V]]*list.Element
+	freq    map[*entry[K, V]]int
+}
+
+func newLFUPolicy[K comparable, V any]() *lfuPolicy[K, V] {
+	return &lfuPolicy[K, V]{
+		buckets: list.New(),
+		bucket:  make(map[*entry[K, V]]*list.Element),
+		freq:    make(map[*entry[K, V]]int),
+	}
+}
+
+func (p *lfuPolicy[K, V]) OnInsert(ent *entry[K, V]) {
+	p.insertAt(ent, 1, p.buckets.Front())
+}
+
+func (p *lfuPolicy[K, V]) OnAccess(ent *entry[K, V]) {
+	curEl, ok := p.bucket[ent]
+	if !ok {
+		return
+	}
+	newFreq := p.freq[ent] + 1
+	next := curEl.Next()
+	p.detach(ent, curEl)
+	p.insertAt(ent, newFreq, next)
+}
+
+func (p *lfuPolicy[K, V]) OnRemove(ent *entry[K, V]) {
+	if el, ok := p.bucket[ent]; ok {
+		p.detach(ent, el)
+	}
+	delete(p.freq, ent)
+}
+
+func (p *lfuPolicy[K, V]) Evict() *entry[K, V] {
+	front := p.buckets.Front()
+	if front == nil {
+		return nil
+	}
+	for ent := range front.Value.(*lfuBucket[K, V]).entries {
+		return ent
+	}
+	return nil
+}
+
+// insertAt places ent into the bucket with the given freq, creating a new
+// bucket right before hint if one with that freq doesn't already exist.
+// hint must either be nil (append at the back) or point to a bucket whose
+// freq is >= the given freq.
+func (p *lfuPolicy[K, V]) insertAt(ent *entry[K, V], freq int, hint *list.Element) {
+	var el *list.Element
+	if hint != nil && hint.Value.(*lfuBucket[K, V]).freq == freq {
+		el = hint
+	} else {
+		b := &lfuBucket[K, V]{freq: freq, entries: make(map[*entry[K, V]]struct{})}
+		if hint == nil {
+			el = p.buckets.PushBack(b)
+		} else {
+			el = p.buckets.InsertBefore(b, hint)
+		}
+	}
+	el.Value.(*lfuBucket[K, V]).entries[ent] = struct{}{}
+	p.bucket[ent] = el
+	p.freq[ent] = freq
+}
+
+// detach removes ent from its current bucket el, deleting the bucket if it
+// becomes empty.
+func (p *lfuPolicy[K, V]) detach(ent *entry[K, V], el *list.Element) {
+	b := el.Value.(*lfuBucket[K, V])
+	delete(b.entries, ent)
+	if len(b.entries) == 0 {
+		p.buckets.Remove(el)
+	}
+}
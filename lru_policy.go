@@ -0,0 +1,42 @@
+package main
+
+import "container/list"
+
+// lruPolicy evicts the least-recently-used entry: the classic policy, and
+// the one the cache used exclusively before EvictionPolicy was pluggable.
+type lruPolicy[K comparable, V any] struct {
+	list *list.List
+	elem map[*entry[K, V]]*list.Element
+}
+
+func newLRUPolicy[K comparable, V any]() *lruPolicy[K, V] {
+	return &lruPolicy[K, V]{
+		list: list.New(),
+		elem: make(map[*entry[K, V]]*list.Element),
+	}
+}
+
+func (p *lruPolicy[K, V]) OnInsert(ent *entry[K, V]) {
+	p.elem[ent] = p.list.PushFront(ent)
+}
+
+func (p *lruPolicy[K, V]) OnAccess(ent *entry[K, V]) {
+	if el, ok := p.elem[ent]; ok {
+		p.list.MoveToFront(el)
+	}
+}
+
+func (p *lruPolicy[K, V]) OnRemove(ent *entry[K, V]) {
+	if el, ok := p.elem[ent]; ok {
+		p.list.Remove(el)
+		delete(p.elem, ent)
+	}
+}
+
+func (p *lruPolicy[K, V]) Evict() *entry[K, V] {
+	el := p.list.Back()
+	if el == nil {
+		return nil
+	}
+	return el.Value.(*entry[K, V])
+}
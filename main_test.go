@@ -7,12 +7,12 @@ import (
 	"time"
 )
 
-// TestMemCacheSetGet tests the Set and Get methods of MemCache
+// TestMemCacheSetGet tests the Set and Get methods of BytesCache
 func TestMemCacheSetGet(t *testing.T) {
-	cache := NewCache(WithTTL(5 * time.Second))
+	cache := NewBytesCache(WithBytesTTL(5 * time.Second))
 
 	// Test set operation
-	err := cache.Set("bucket", "key", []byte("value"))
+	err := cache.Set("bucket", "key", []byte("value"), 0)
 	if err != nil {
 		t.Errorf("Set failed: %v", err)
 	}
@@ -29,31 +29,31 @@ func TestMemCacheSetGet(t *testing.T) {
 
 // TestMemCacheExpiration tests that entries are properly expired based on TTL
 func TestMemCacheExpiration(t *testing.T) {
-	cache := NewCache(WithTTL(1 * time.Second))
+	cache := NewBytesCache(WithBytesTTL(1 * time.Second))
 
-	_ = cache.Set("bucket", "key", []byte("value"))
+	_ = cache.Set("bucket", "key", []byte("value"), 0)
 	time.Sleep(2 * time.Second) // wait for the key to expire
 
 	_, err := cache.Get("bucket", "key")
-	if err == nil || err.Error() != "cache expired" {
-		t.Errorf("Expected 'cache expired' error, got %v", err)
+	if err == nil || err.Error() != "not found" {
+		t.Errorf("Expected 'not found' error for an expired key, got %v", err)
 	}
 }
 
 // TestMemCacheEviction tests the eviction policy when the cache reaches its max size
 func TestMemCacheEviction(t *testing.T) {
-	cache := NewCache(WithEvictionPolicy("Oldest"))
+	cache := NewBytesCache(WithBytesEvictionPolicy("Oldest"))
 
 	// Fill the cache to its max capacity
 	for i := 0; i < MaxEntries; i++ {
-		err := cache.Set("bucket", fmt.Sprintf("%d", i), []byte("value"))
+		err := cache.Set("bucket", fmt.Sprintf("%d", i), []byte("value"), 0)
 		if err != nil {
 			t.Fatalf("Set failed at iteration %d: %v", i, err)
 		}
 	}
 
 	// Add one more item, triggering eviction
-	err := cache.Set("bucket", "extra_key", []byte("value"))
+	err := cache.Set("bucket", "extra_key", []byte("value"), 0)
 	if err != nil {
 		t.Fatalf("Set failed during eviction: %v", err)
 	}
@@ -67,9 +67,9 @@ func TestMemCacheEviction(t *testing.T) {
 
 // TestMemCacheDelete tests the Delete method
 func TestMemCacheDelete(t *testing.T) {
-	cache := NewCache()
+	cache := NewBytesCache()
 
-	_ = cache.Set("bucket", "key", []byte("value"))
+	_ = cache.Set("bucket", "key", []byte("value"), 0)
 
 	// Test delete operation
 	err := cache.Delete("bucket", "key")
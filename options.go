@@ -0,0 +1,94 @@
+package main
+
+import "time"
+
+// Options configures a generic Cache.
+type Options[K comparable, V any] struct {
+	TTL                time.Duration
+	EvictionPolicyName string
+	Policy             EvictionPolicy[K, V]
+	MaxEntries         int
+	MaxBytes           int64
+	SizeOf             func(value V) int64
+	OnEvicted          func(key K, value V, reason EvictReason)
+	SweepInterval      time.Duration
+}
+
+// Option mutates Options when applied by NewCache.
+type Option[K comparable, V any] func(*Options[K, V])
+
+// resolveOptions applies opts over NewCache's defaults, the same way
+// NewCache itself does. It's also used by NewShardedCache, which needs the
+// fully-resolved MaxEntries/MaxBytes to split a caller's intended total
+// capacity across shards instead of applying it to each shard unscaled.
+func resolveOptions[K comparable, V any](opts ...Option[K, V]) Options[K, V] {
+	o := Options[K, V]{
+		EvictionPolicyName: "Oldest",
+		MaxEntries:         MaxEntries,
+		SweepInterval:      defaultSweepInterval,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithTTL sets the time-to-live applied to entries that don't specify
+// their own TTL on Set.
+func WithTTL[K comparable, V any](ttl time.Duration) Option[K, V] {
+	return func(o *Options[K, V]) {
+		o.TTL = ttl
+	}
+}
+
+// WithEvictionPolicy sets the eviction policy used when the cache is full.
+// p may be either a built-in policy name ("Oldest"/"LRU", "LFU", "2Q") or an
+// EvictionPolicy[K, V] instance for full custom control.
+func WithEvictionPolicy[K comparable, V any](p any) Option[K, V] {
+	return func(o *Options[K, V]) {
+		switch v := p.(type) {
+		case string:
+			o.EvictionPolicyName = v
+		case EvictionPolicy[K, V]:
+			o.Policy = v
+		}
+	}
+}
+
+// WithMaxEntries sets the maximum number of entries the cache will hold
+// before evicting. A value of 0 disables the entry-count limit.
+func WithMaxEntries[K comparable, V any](n int) Option[K, V] {
+	return func(o *Options[K, V]) {
+		o.MaxEntries = n
+	}
+}
+
+// WithMaxBytes caps the cache by total value size in bytes instead of (or
+// in addition to) entry count. sizeOf computes the cost of a single value;
+// each entry also accrues a small fixed overhead on top of that to account
+// for the cache's own bookkeeping. n <= 0 disables the byte limit, which is
+// the default.
+func WithMaxBytes[K comparable, V any](n int64, sizeOf func(value V) int64) Option[K, V] {
+	return func(o *Options[K, V]) {
+		o.MaxBytes = n
+		o.SizeOf = sizeOf
+	}
+}
+
+// WithOnEvicted registers a callback fired whenever an entry leaves the
+// cache, whether through TTL expiry, capacity eviction, a Remove call, or
+// Set replacing an existing value.
+func WithOnEvicted[K comparable, V any](fn func(key K, value V, reason EvictReason)) Option[K, V] {
+	return func(o *Options[K, V]) {
+		o.OnEvicted = fn
+	}
+}
+
+// WithSweepInterval sets how often the background sweeper scans for expired
+// entries. A value <= 0 disables the sweeper; expired entries are then only
+// reclaimed lazily, on access.
+func WithSweepInterval[K comparable, V any](d time.Duration) Option[K, V] {
+	return func(o *Options[K, V]) {
+		o.SweepInterval = d
+	}
+}
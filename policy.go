@@ -0,0 +1,32 @@
+package main
+
+// EvictionPolicy decides which entry a Cache should evict when it's full,
+// and tracks whatever bookkeeping (recency, frequency, queue placement...)
+// it needs to make that decision in O(1). The cache core calls these hooks
+// on every access and mutation; Evict only returns a candidate, it's the
+// cache's job to then call OnRemove for the entry it actually drops.
+type EvictionPolicy[K comparable, V any] interface {
+	// OnInsert is called once, when ent is first added to the cache.
+	OnInsert(ent *entry[K, V])
+	// OnAccess is called on every cache hit (Get, or Set replacing a value).
+	OnAccess(ent *entry[K, V])
+	// OnRemove is called whenever ent leaves the cache, for any reason.
+	OnRemove(ent *entry[K, V])
+	// Evict returns the entry the policy would drop next, or nil if the
+	// policy is tracking nothing. It does not remove the entry itself.
+	Evict() *entry[K, V]
+}
+
+// newPolicyByName resolves one of the built-in policy names to a fresh
+// policy instance. Unrecognized names fall back to LRU, matching the
+// pre-pluggable-policy default of evicting list.Back().
+func newPolicyByName[K comparable, V any](name string) EvictionPolicy[K, V] {
+	switch name {
+	case "LFU":
+		return newLFUPolicy[K, V]()
+	case "2Q":
+		return newTwoQPolicy[K, V](default2QGhostSize)
+	default: // "Oldest", "LRU", or anything unrecognized
+		return newLRUPolicy[K, V]()
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import "testing"
+
+// TestLRUPolicyEvictsLeastRecentlyUsed verifies the LRU policy evicts the
+// entry that's gone longest without an access.
+func TestLRUPolicyEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewCache[string, int](
+		WithMaxEntries[string, int](2),
+		WithEvictionPolicy[string, int]("LRU"),
+	)
+
+	cache.Set("a", 1, 0)
+	cache.Set("b", 2, 0)
+	cache.Get("a") // "b" is now the least recently used
+	cache.Set("c", 3, 0)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("expected \"b\" to have been evicted")
+	}
+	if _, ok := cache.Get("a"); !ok {
+		t.Errorf("expected \"a\" to still be present")
+	}
+}
+
+// TestLFUPolicyEvictsLeastFrequentlyUsed verifies the LFU policy evicts the
+// entry with the fewest accesses, not the oldest or least recent one.
+func TestLFUPolicyEvictsLeastFrequentlyUsed(t *testing.T) {
+	cache := NewCache[string, int](
+		WithMaxEntries[string, int](2),
+		WithEvictionPolicy[string, int]("LFU"),
+	)
+
+	cache.Set("a", 1, 0)
+	cache.Set("b", 2, 0)
+	cache.Get("a")
+	cache.Get("a") // "a" now has more hits than "b"
+	cache.Set("c", 3, 0)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("expected \"b\" (fewer accesses) to have been evicted")
+	}
+	if _, ok := cache.Get("a"); !ok {
+		t.Errorf("expected \"a\" (more accesses) to still be present")
+	}
+}
+
+// TestTwoQPolicyPromotesOnSecondAccess verifies that an entry accessed
+// twice while still in A1in is promoted to Am and survives A1in pressure.
+func TestTwoQPolicyPromotesOnSecondAccess(t *testing.T) {
+	cache := NewCache[string, int](
+		WithMaxEntries[string, int](2),
+		WithEvictionPolicy[string, int]("2Q"),
+	)
+
+	cache.Set("a", 1, 0)
+	cache.Get("a") // promotes "a" from A1in to Am
+	cache.Set("b", 2, 0)
+	cache.Set("c", 3, 0) // A1in is full; "b" (never re-accessed) should go
+
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("expected \"b\" to have been evicted from A1in")
+	}
+	if _, ok := cache.Get("a"); !ok {
+		t.Errorf("expected \"a\" (promoted to Am) to still be present")
+	}
+}
+
+// TestWithEvictionPolicyAcceptsCustomInstance verifies WithEvictionPolicy
+// accepts a policy instance in addition to a built-in name.
+func TestWithEvictionPolicyAcceptsCustomInstance(t *testing.T) {
+	custom := newLRUPolicy[string, int]()
+	cache := NewCache[string, int](
+		WithMaxEntries[string, int](1),
+		WithEvictionPolicy[string, int](EvictionPolicy[string, int](custom)),
+	)
+
+	cache.Set("a", 1, 0)
+	cache.Set("b", 2, 0)
+
+	if _, ok := cache.Get("a"); ok {
+		t.Errorf("expected \"a\" to have been evicted by the supplied policy")
+	}
+}
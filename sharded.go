@@ -0,0 +1,196 @@
+package main
+
+import (
+	"fmt"
+	"hash/fnv"
+	"runtime"
+	"time"
+)
+
+// ShardedCache spreads keys across N independent Cache shards, each with its
+// own lock, LRU list, and expiration index, to reduce contention under
+// concurrent load compared to a single global mutex. It implements Cache[K,
+// V], so it's a drop-in replacement for NewCache's result.
+type ShardedCache[K comparable, V any] struct {
+	shards []Cache[K, V]
+	mask   uint64
+	hash   func(key K) uint64
+}
+
+// NewShardedCache creates a ShardedCache of shardCount independent shards,
+// each configured with opts. shardCount <= 0 defaults to
+// runtime.GOMAXPROCS(0)*4; either way it's rounded up to the next power of
+// two so shard selection is a cheap bitmask rather than a modulo. hash
+// computes the shard for a key; pass nil to use an FNV-1a default that
+// hashes strings directly and falls back to fmt.Sprintf for other
+// comparable types.
+//
+// MaxEntries and MaxBytes in opts describe the cache's *total* capacity, not
+// each shard's: they're divided (rounded up) across shardCount so the
+// aggregate matches what the caller asked for, the same as a single
+// unsharded Cache. A per-shard entry or byte budget can therefore be as low
+// as 1 once split, which skews eviction slightly earlier than an exact
+// global budget would; that trade-off is the cost of each shard enforcing
+// its own limit without coordinating with the others.
+func NewShardedCache[K comparable, V any](shardCount int, hash func(key K) uint64, opts ...Option[K, V]) *ShardedCache[K, V] {
+	if shardCount <= 0 {
+		shardCount = runtime.GOMAXPROCS(0) * 4
+	}
+	shardCount = nextPowerOfTwo(shardCount)
+
+	if hash == nil {
+		hash = fnvHash[K]
+	}
+
+	resolved := resolveOptions(opts...)
+	shardOpts := append([]Option[K, V]{}, opts...)
+	if resolved.MaxEntries > 0 {
+		shardOpts = append(shardOpts, WithMaxEntries[K, V](ceilDiv(resolved.MaxEntries, shardCount)))
+	}
+	if resolved.MaxBytes > 0 {
+		shardOpts = append(shardOpts, WithMaxBytes[K, V](ceilDiv64(resolved.MaxBytes, int64(shardCount)), resolved.SizeOf))
+	}
+
+	shards := make([]Cache[K, V], shardCount)
+	for i := range shards {
+		shards[i] = NewCache[K, V](shardOpts...)
+	}
+
+	return &ShardedCache[K, V]{shards: shards, mask: uint64(shardCount - 1), hash: hash}
+}
+
+// shardFor returns the shard responsible for key.
+func (c *ShardedCache[K, V]) shardFor(key K) Cache[K, V] {
+	return c.shards[c.hash(key)&c.mask]
+}
+
+// Set inserts or updates the value for key in its shard.
+func (c *ShardedCache[K, V]) Set(key K, value V, ttl time.Duration) {
+	c.shardFor(key).Set(key, value, ttl)
+}
+
+// Get retrieves the value for key from its shard.
+func (c *ShardedCache[K, V]) Get(key K) (V, bool) {
+	return c.shardFor(key).Get(key)
+}
+
+// Peek returns the value for key without affecting its recency.
+func (c *ShardedCache[K, V]) Peek(key K) (V, bool) {
+	return c.shardFor(key).Peek(key)
+}
+
+// Contains reports whether key is present without affecting recency.
+func (c *ShardedCache[K, V]) Contains(key K) bool {
+	return c.shardFor(key).Contains(key)
+}
+
+// Remove deletes key from its shard, reporting whether it was present.
+func (c *ShardedCache[K, V]) Remove(key K) bool {
+	return c.shardFor(key).Remove(key)
+}
+
+// Purge removes all entries from every shard.
+func (c *ShardedCache[K, V]) Purge() {
+	for _, s := range c.shards {
+		s.Purge()
+	}
+}
+
+// Keys returns the keys currently in the cache, across all shards. Order is
+// unspecified; see Cache.Keys.
+func (c *ShardedCache[K, V]) Keys() []K {
+	var keys []K
+	for _, s := range c.shards {
+		keys = append(keys, s.Keys()...)
+	}
+	return keys
+}
+
+// Values returns the values currently in the cache, across all shards.
+// Order is unspecified; see Cache.Values.
+func (c *ShardedCache[K, V]) Values() []V {
+	var values []V
+	for _, s := range c.shards {
+		values = append(values, s.Values()...)
+	}
+	return values
+}
+
+// Len returns the number of entries across all shards.
+func (c *ShardedCache[K, V]) Len() int {
+	total := 0
+	for _, s := range c.shards {
+		total += s.Len()
+	}
+	return total
+}
+
+// Stats returns the sum of every shard's hit/miss/eviction counters, entry
+// count, and byte usage.
+func (c *ShardedCache[K, V]) Stats() Stats {
+	var agg Stats
+	for _, s := range c.shards {
+		st := s.Stats()
+		agg.Hits += st.Hits
+		agg.Misses += st.Misses
+		agg.Evictions += st.Evictions
+		agg.Entries += st.Entries
+		agg.BytesInUse += st.BytesInUse
+	}
+	return agg
+}
+
+// Close stops every shard's background sweeper.
+func (c *ShardedCache[K, V]) Close() error {
+	for _, s := range c.shards {
+		if err := s.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextPowerOfTwo rounds n up to the next power of two, so shard selection
+// can use a bitmask instead of a modulo. n <= 0 rounds up to 1.
+func nextPowerOfTwo(n int) int {
+	if n < 1 {
+		return 1
+	}
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
+
+// ceilDiv divides n by d, rounding up, with a floor of 1 so a capacity
+// smaller than the shard count still leaves every shard able to hold at
+// least one entry rather than disabling its limit outright.
+func ceilDiv(n, d int) int {
+	if q := (n + d - 1) / d; q > 0 {
+		return q
+	}
+	return 1
+}
+
+// ceilDiv64 is ceilDiv for int64 byte budgets.
+func ceilDiv64(n, d int64) int64 {
+	if q := (n + d - 1) / d; q > 0 {
+		return q
+	}
+	return 1
+}
+
+// fnvHash is the default shard hash: FNV-1a over the key's bytes for
+// strings, or over its fmt.Sprintf representation for any other comparable
+// type. Callers with a hot path over a non-string key should supply their
+// own hash to NewShardedCache instead.
+func fnvHash[K comparable](key K) uint64 {
+	h := fnv.New64a()
+	if s, ok := any(key).(string); ok {
+		h.Write([]byte(s))
+	} else {
+		fmt.Fprintf(h, "%v", key)
+	}
+	return h.Sum64()
+}
@@ -0,0 +1,115 @@
+package main
+
+import "testing"
+
+// TestShardedCacheSetGet verifies that ShardedCache routes Set/Get to a
+// consistent shard so values round-trip regardless of shard count.
+func TestShardedCacheSetGet(t *testing.T) {
+	cache := NewShardedCache[string, int](4, nil)
+
+	cache.Set("a", 1, 0)
+	cache.Set("b", 2, 0)
+
+	if val, ok := cache.Get("a"); !ok || val != 1 {
+		t.Errorf("Get(%q) = %d, %v, want 1, true", "a", val, ok)
+	}
+	if val, ok := cache.Get("b"); !ok || val != 2 {
+		t.Errorf("Get(%q) = %d, %v, want 2, true", "b", val, ok)
+	}
+}
+
+// TestShardedCacheShardCountRoundsUpToPowerOfTwo verifies shardCount is
+// rounded up so shard selection can use a bitmask.
+func TestShardedCacheShardCountRoundsUpToPowerOfTwo(t *testing.T) {
+	cache := NewShardedCache[string, int](5, nil)
+
+	if got := len(cache.shards); got != 8 {
+		t.Errorf("expected shardCount 5 to round up to 8, got %d", got)
+	}
+}
+
+// TestShardedCacheAggregatesLenAndStats verifies Len and Stats sum across
+// every shard rather than reporting a single shard's view.
+func TestShardedCacheAggregatesLenAndStats(t *testing.T) {
+	cache := NewShardedCache[string, int](4, nil)
+
+	for i := 0; i < 20; i++ {
+		cache.Set(string(rune('a'+i)), i, 0)
+	}
+	for i := 0; i < 20; i++ {
+		cache.Get(string(rune('a' + i)))
+	}
+	cache.Get("missing")
+
+	if cache.Len() != 20 {
+		t.Errorf("expected Len to be 20, got %d", cache.Len())
+	}
+
+	stats := cache.Stats()
+	if stats.Hits != 20 {
+		t.Errorf("expected 20 hits across shards, got %d", stats.Hits)
+	}
+	if stats.Misses != 1 {
+		t.Errorf("expected 1 miss, got %d", stats.Misses)
+	}
+	if stats.Entries != 20 {
+		t.Errorf("expected 20 entries, got %d", stats.Entries)
+	}
+}
+
+// TestShardedCachePurgeClearsEveryShard verifies Purge empties all shards,
+// not just the one holding the first key inserted.
+func TestShardedCachePurgeClearsEveryShard(t *testing.T) {
+	cache := NewShardedCache[string, int](4, nil)
+
+	for i := 0; i < 20; i++ {
+		cache.Set(string(rune('a'+i)), i, 0)
+	}
+	cache.Purge()
+
+	if cache.Len() != 0 {
+		t.Errorf("expected cache to be empty after Purge, got %d entries", cache.Len())
+	}
+}
+
+// TestShardedCacheSplitsMaxEntriesAcrossShards verifies that a MaxEntries
+// budget passed to NewShardedCache bounds the cache's total size rather
+// than being applied unscaled to every shard.
+func TestShardedCacheSplitsMaxEntriesAcrossShards(t *testing.T) {
+	const shardCount = 4
+	const maxEntries = 16
+	cache := NewShardedCache[int, int](shardCount, func(key int) uint64 { return uint64(key) }, WithMaxEntries[int, int](maxEntries))
+
+	for i := 0; i < maxEntries*shardCount; i++ {
+		cache.Set(i, i, 0)
+	}
+
+	if got := cache.Len(); got > maxEntries {
+		t.Errorf("expected total entries capped around %d, got %d", maxEntries, got)
+	}
+}
+
+// TestShardedBytesCacheSetGetDelete exercises the bucket/key facade over a
+// sharded backing cache.
+func TestShardedBytesCacheSetGetDelete(t *testing.T) {
+	cache := NewShardedBytesCache(4)
+
+	if err := cache.Set("bucket", "key", []byte("value"), 0); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+
+	value, err := cache.Get("bucket", "key")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if string(value) != "value" {
+		t.Errorf("Get returned %q, want %q", value, "value")
+	}
+
+	if err := cache.Delete("bucket", "key"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := cache.Get("bucket", "key"); err == nil {
+		t.Errorf("expected Get to fail after Delete")
+	}
+}
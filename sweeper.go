@@ -0,0 +1,76 @@
+package main
+
+import (
+	"runtime"
+	"sync"
+	"time"
+)
+
+// defaultSweepInterval is how often the background sweeper scans for
+// expired entries when the caller doesn't specify one.
+const defaultSweepInterval = time.Minute
+
+// cacheHandle is the value NewCache actually returns. It embeds the inner
+// cache (so it gets all of Cache's methods for free) and owns the
+// background sweeper's stop channel.
+//
+// The sweeper goroutine closes over inner and stop only, never the handle
+// itself, so a handle that's dropped without a call to Close is still
+// eligible for garbage collection; the finalizer set below then stops the
+// goroutine for it. This mirrors the fix hashicorp/golang-lru applied to
+// its expirable LRU for the same reason: a goroutine that closes over the
+// struct it's finalized on can never see that struct collected.
+type cacheHandle[K comparable, V any] struct {
+	*cache[K, V]
+	stop      chan struct{}
+	closeOnce sync.Once
+}
+
+// newCacheHandle wraps inner, starts its sweeper (unless interval <= 0),
+// and arms the finalizer.
+func newCacheHandle[K comparable, V any](inner *cache[K, V], interval time.Duration) *cacheHandle[K, V] {
+	h := &cacheHandle[K, V]{cache: inner, stop: make(chan struct{})}
+	startSweeper(inner, interval, h.stop)
+	runtime.SetFinalizer(h, func(h *cacheHandle[K, V]) {
+		h.stopSweeper()
+	})
+	return h
+}
+
+// Close stops the background sweeper. It's safe to call more than once, safe
+// to call even if the sweeper was never started, and safe to call
+// concurrently with itself or with the finalizer-driven shutdown.
+func (h *cacheHandle[K, V]) Close() error {
+	runtime.SetFinalizer(h, nil)
+	h.stopSweeper()
+	return nil
+}
+
+// stopSweeper closes stop exactly once. closeOnce, rather than a
+// check-then-close select, is what makes this safe against concurrent
+// callers: two goroutines racing a plain "select on stop, default: close"
+// can both observe the default case and both call close, panicking.
+func (h *cacheHandle[K, V]) stopSweeper() {
+	h.closeOnce.Do(func() { close(h.stop) })
+}
+
+// startSweeper launches the single background goroutine that periodically
+// evicts expired entries from inner. Passing interval <= 0 disables it.
+func startSweeper[K comparable, V any](inner *cache[K, V], interval time.Duration, stop chan struct{}) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				inner.sweep()
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
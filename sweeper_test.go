@@ -0,0 +1,60 @@
+package main
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestCacheSweeper verifies that the background sweeper reclaims expired
+// entries on its own, without a Get ever being called on them.
+func TestCacheSweeper(t *testing.T) {
+	cache := NewCache[string, int](
+		WithTTL[string, int](10*time.Millisecond),
+		WithSweepInterval[string, int](15*time.Millisecond),
+	)
+	defer cache.Close()
+
+	cache.Set("a", 1, 0)
+	if cache.Len() != 1 {
+		t.Fatalf("expected 1 entry, got %d", cache.Len())
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	if cache.Len() != 0 {
+		t.Errorf("expected sweeper to have reclaimed the expired entry, got %d entries", cache.Len())
+	}
+}
+
+// TestCacheCloseIsIdempotent verifies Close can be called more than once
+// without panicking.
+func TestCacheCloseIsIdempotent(t *testing.T) {
+	cache := NewCache[string, int](WithSweepInterval[string, int](time.Millisecond))
+
+	if err := cache.Close(); err != nil {
+		t.Fatalf("unexpected error from Close: %v", err)
+	}
+	if err := cache.Close(); err != nil {
+		t.Fatalf("unexpected error from second Close: %v", err)
+	}
+}
+
+// TestCacheCloseConcurrent verifies Close doesn't panic when called from
+// multiple goroutines at once (run with -race to catch a close-of-closed-
+// channel regression).
+func TestCacheCloseConcurrent(t *testing.T) {
+	cache := NewCache[string, int](WithSweepInterval[string, int](time.Millisecond))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := cache.Close(); err != nil {
+				t.Errorf("unexpected error from concurrent Close: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
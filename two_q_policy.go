@@ -0,0 +1,109 @@
+package main
+
+import "container/list"
+
+// default2QGhostSize bounds the A1out ghost queue when a twoQPolicy is
+// selected by name rather than constructed directly.
+const default2QGhostSize = MaxEntries
+
+// twoQLocation records which of the two live queues an entry sits in.
+type twoQLocation int
+
+const (
+	twoQNone twoQLocation = iota
+	twoQIn                // A1in: a FIFO of entries seen exactly once
+	twoQMain              // Am: an LRU of entries seen more than once
+)
+
+// twoQPolicy implements the 2Q eviction policy: new entries start in a FIFO
+// (A1in) rather than competing with hot entries for LRU position. An entry
+// evicted from A1in leaves its key behind in a ghost FIFO (A1out); if that
+// key is reinserted before falling out of A1out, it's promoted straight
+// into the LRU main queue (Am) instead of starting over in A1in. An entry
+// accessed a second time while still in A1in is also promoted to Am.
+type twoQPolicy[K comparable, V any] struct {
+	a1in  *list.List // of *entry[K, V]
+	a1out *list.List // of K (ghost keys)
+	am    *list.List // of *entry[K, V]
+
+	inElem    map[*entry[K, V]]*list.Element
+	amElem    map[*entry[K, V]]*list.Element
+	ghostElem map[K]*list.Element
+	location  map[*entry[K, V]]twoQLocation
+
+	maxGhost int
+}
+
+func newTwoQPolicy[K comparable, V any](maxGhost int) *twoQPolicy[K, V] {
+	return &twoQPolicy[K, V]{
+		a1in:      list.New(),
+		a1out:     list.New(),
+		am:        list.New(),
+		inElem:    make(map[*entry[K, V]]*list.Element),
+		amElem:    make(map[*entry[K, V]]*list.Element),
+		ghostElem: make(map[K]*list.Element),
+		location:  make(map[*entry[K, V]]twoQLocation),
+		maxGhost:  maxGhost,
+	}
+}
+
+func (p *twoQPolicy[K, V]) OnInsert(ent *entry[K, V]) {
+	if el, ok := p.ghostElem[ent.key]; ok {
+		p.a1out.Remove(el)
+		delete(p.ghostElem, ent.key)
+		p.location[ent] = twoQMain
+		p.amElem[ent] = p.am.PushFront(ent)
+		return
+	}
+	p.location[ent] = twoQIn
+	p.inElem[ent] = p.a1in.PushFront(ent)
+}
+
+func (p *twoQPolicy[K, V]) OnAccess(ent *entry[K, V]) {
+	switch p.location[ent] {
+	case twoQMain:
+		p.am.MoveToFront(p.amElem[ent])
+	case twoQIn:
+		p.a1in.Remove(p.inElem[ent])
+		delete(p.inElem, ent)
+		p.location[ent] = twoQMain
+		p.amElem[ent] = p.am.PushFront(ent)
+	}
+}
+
+func (p *twoQPolicy[K, V]) OnRemove(ent *entry[K, V]) {
+	switch p.location[ent] {
+	case twoQMain:
+		p.am.Remove(p.amElem[ent])
+		delete(p.amElem, ent)
+	case twoQIn:
+		p.a1in.Remove(p.inElem[ent])
+		delete(p.inElem, ent)
+		p.ghost(ent.key)
+	}
+	delete(p.location, ent)
+}
+
+func (p *twoQPolicy[K, V]) Evict() *entry[K, V] {
+	if el := p.a1in.Back(); el != nil {
+		return el.Value.(*entry[K, V])
+	}
+	if el := p.am.Back(); el != nil {
+		return el.Value.(*entry[K, V])
+	}
+	return nil
+}
+
+// ghost remembers key as recently removed from A1in so a prompt
+// reinsertion promotes straight to Am instead of starting over in A1in.
+func (p *twoQPolicy[K, V]) ghost(key K) {
+	if p.maxGhost <= 0 {
+		return
+	}
+	p.ghostElem[key] = p.a1out.PushFront(key)
+	if p.a1out.Len() > p.maxGhost {
+		back := p.a1out.Back()
+		delete(p.ghostElem, back.Value.(K))
+		p.a1out.Remove(back)
+	}
+}